@@ -0,0 +1,165 @@
+package gotwilio
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListMessagesParams filters and bounds a message listing. PageSize
+// defaults to 1000 (Twilio's maximum) when zero. MaxPages, if non-zero,
+// stops the iterator after that many pages have been fetched regardless of
+// whether Twilio reports more.
+type ListMessagesParams struct {
+	To                    string
+	From                  string
+	DateCreatedOnOrBefore string
+	DateCreatedAfter      string
+	PageSize              int
+	MaxPages              int
+}
+
+// MessagesIterator lazily walks the pages of a Messages listing, fetching
+// the next page only when the current one is exhausted. Create one with
+// Twilio.NewMessagesIterator and drive it with Next.
+type MessagesIterator struct {
+	twilio  *Twilio
+	params  ListMessagesParams
+	nextUrl string
+	pending []*SmsResponse
+	page    int
+	done    bool
+}
+
+// NewMessagesIterator creates a MessagesIterator for the given filters. No
+// request is made until Next is called.
+func (twilio *Twilio) NewMessagesIterator(params ListMessagesParams) *MessagesIterator {
+	if params.PageSize == 0 {
+		params.PageSize = 1000
+	}
+
+	return &MessagesIterator{twilio: twilio, params: params}
+}
+
+// Next returns the next message in the listing, fetching additional pages
+// as needed. The returned bool is false once the listing (or MaxPages,
+// whichever comes first) is exhausted, at which point sms and err are nil.
+// Next returns an error that is or wraps ctx.Err() if ctx is cancelled
+// while a page fetch is in flight.
+func (it *MessagesIterator) Next(ctx context.Context) (sms *SmsResponse, ok bool, err error) {
+	for len(it.pending) == 0 {
+		if it.done {
+			return nil, false, nil
+		}
+
+		if err := it.fetchPage(ctx); err != nil {
+			return nil, false, err
+		}
+	}
+
+	sms, it.pending = it.pending[0], it.pending[1:]
+	return sms, true, nil
+}
+
+func (it *MessagesIterator) fetchPage(ctx context.Context) error {
+	twilioUrl := it.nextUrl
+	if twilioUrl == "" {
+		twilioUrl = it.firstPageUrl()
+	}
+
+	lr, err := it.twilio.getMessagesPage(ctx, twilioUrl)
+	if err != nil {
+		return err
+	}
+
+	it.pending = lr.Messages
+	it.page++
+
+	if lr.NextPageUri == "" || (it.params.MaxPages > 0 && it.page >= it.params.MaxPages) {
+		it.done = true
+	} else {
+		// NextPageUri is host-relative and already carries the API version
+		// path, so only BaseUrl's scheme and host are put in front of it.
+		root := "https://api.twilio.com"
+		if base, err := url.Parse(it.twilio.BaseUrl); err == nil && base.Host != "" {
+			root = base.Scheme + "://" + base.Host
+		}
+		it.nextUrl = root + lr.NextPageUri
+	}
+
+	return nil
+}
+
+func (it *MessagesIterator) firstPageUrl() string {
+	values := url.Values{}
+	if it.params.To != "" {
+		values.Set("To", it.params.To)
+	}
+	if it.params.From != "" {
+		values.Set("From", it.params.From)
+	}
+	if it.params.DateCreatedOnOrBefore != "" {
+		values.Set("DateCreatedOnOrBefore", it.params.DateCreatedOnOrBefore)
+	}
+	if it.params.DateCreatedAfter != "" {
+		values.Set("DateCreatedAfter", it.params.DateCreatedAfter)
+	}
+	values.Set("PageSize", strconv.Itoa(it.params.PageSize))
+
+	twilioUrl := it.twilio.BaseUrl + "/Accounts/" + it.twilio.AccountSid + "/Messages.json"
+	return twilioUrl + "?" + values.Encode()
+}
+
+// getMessagesPage fetches a single page of the Messages listing through the
+// client's shared transport, so a rate limit or retry policy configured via
+// NewTwilio applies here the same as it does to every other request.
+func (twilio *Twilio) getMessagesPage(ctx context.Context, twilioUrl string) (*ListResources, error) {
+	resp, err := twilio.do(ctx, http.MethodGet, twilioUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		exc := new(Exception)
+		if err := json.Unmarshal(respBody, exc); err != nil {
+			return nil, err
+		}
+		return nil, exc
+	}
+
+	lr := twilio.newListResources()
+	if err := json.Unmarshal(respBody, lr); err != nil {
+		return nil, err
+	}
+	return lr, nil
+}
+
+// GetMessagesContext drains a MessagesIterator for params into a slice,
+// honoring ctx cancellation between page fetches.
+func (twilio *Twilio) GetMessagesContext(ctx context.Context, params ListMessagesParams) ([]*SmsResponse, *Exception, error) {
+	it := twilio.NewMessagesIterator(params)
+
+	var all []*SmsResponse
+	for {
+		sms, ok, err := it.Next(ctx)
+		if err != nil {
+			if exc, isExc := err.(*Exception); isExc {
+				return all, exc, nil
+			}
+			return all, nil, err
+		}
+		if !ok {
+			return all, nil, nil
+		}
+		all = append(all, sms)
+	}
+}
@@ -1,9 +1,9 @@
 package gotwilio
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"sort"
@@ -64,7 +64,8 @@ func (sms *SmsResponse) GetSegments() int {
 
 	val, err := strconv.Atoi(sms.NumSegments)
 	if err != nil {
-		log.Println("Error getting num segments", sms.Sid, sms.NumSegments)
+		// NumSegments came back unparsable; we don't have a client handle
+		// (and so no structured logger) here, so just fall back to 1.
 		return 1
 	}
 
@@ -195,88 +196,19 @@ func (twilio *Twilio) GetConversation(to, from, createdOnOrBefore, createdAfter
 	return convo, nil, nil
 
 }
-func (twilio *Twilio) GetMessages(to, from, createdOnOrBefore, createdAfter string) ([]*SmsResponse, *Exception, error) {
-	values := url.Values{}
-	if to != "" {
-		values.Set("To", to)
-	}
-	if from != "" {
-		values.Set("From", from)
-	}
-	if createdOnOrBefore != "" {
-		values.Set("DateCreatedOnOrBefore", createdOnOrBefore)
-	}
-	if createdAfter != "" {
-		values.Set("DateCreatedAfter", createdAfter)
-	}
-
-	values.Set("PageSize", "1000")
-
-	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Messages.json"
-
-	// Retrieve all messages FROM the host to the client
-	var (
-		url *url.URL
-		err error
-	)
-	if url, err = url.Parse(twilioUrl); err != nil {
-		return nil, nil, err
-	}
-	url.RawQuery = values.Encode()
 
-	resp, err := twilio.get(url.String())
-	if err != nil {
-		return nil, nil, err
-	}
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		exc := new(Exception)
-		err = json.Unmarshal(respBody, exc)
-		return nil, exc, err
-	}
-
-	lr := twilio.newListResources()
-	if err := json.Unmarshal(respBody, lr); err != nil {
-		return nil, nil, err
-	}
-	frs := lr.Messages
-	log.Println("FIRST TO MSGS", url.String(), len(lr.Messages))
-
-	for {
-		if lr.NextPageUri == "" {
-			break
-		}
-
-		uri := "https://api.twilio.com" + lr.NextPageUri
-		resp, err := twilio.get(uri)
-		if err != nil {
-			return nil, nil, err
-		}
-		respBody, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			exc := new(Exception)
-			err = json.Unmarshal(respBody, exc)
-			return nil, exc, err
-		}
-
-		lr = twilio.newListResources()
-		if err := json.Unmarshal(respBody, lr); err != nil {
-			return nil, nil, err
-		}
-
-		log.Println("NEXT: URI TO MSGS", uri, len(lr.Messages))
-		frs = append(frs, lr.Messages...)
-	}
-
-	return frs, nil, nil
+// GetMessages retrieves every message matching the given filters, walking
+// all pages eagerly. It's kept for backwards compatibility; callers that
+// can't afford to hold every page in memory at once, or that need to cancel
+// a long listing, should use GetMessagesContext or a MessagesIterator
+// instead.
+func (twilio *Twilio) GetMessages(to, from, createdOnOrBefore, createdAfter string) ([]*SmsResponse, *Exception, error) {
+	return twilio.GetMessagesContext(context.Background(), ListMessagesParams{
+		To:                    to,
+		From:                  from,
+		DateCreatedOnOrBefore: createdOnOrBefore,
+		DateCreatedAfter:      createdAfter,
+	})
 }
 
 func (twilio *Twilio) GetMessage(sid string) (*SmsResponse, *Exception, error) {
@@ -2,21 +2,48 @@ package gotwilio
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/google/go-querystring/query"
 )
 
-// IncomingPhoneNumber represents a phone number resource owned by the calling account in Twilio
-type IncomingSubAccount struct {
-	SID          string `json:"sid"`
-	FriendlyName string `url:"FriendlyName,omitempty" json:"FriendlyName"`
-	AuthToken    string `url:"auth_token,omitempty" json:"auth_token"`
+// SubAccount represents an Account resource owned by the calling account in
+// Twilio, i.e. a subaccount.
+type SubAccount struct {
+	SID             string `url:"-" json:"sid"`
+	OwnerAccountSid string `url:"-" json:"owner_account_sid"`
+	FriendlyName    string `url:"FriendlyName,omitempty" json:"friendly_name"`
+	Status          string `url:"Status,omitempty" json:"status"`
+	Type            string `url:"-" json:"type"`
+	AuthToken       string `url:"-" json:"auth_token"`
+	DateCreated     string `url:"-" json:"date_created"`
+	DateUpdated     string `url:"-" json:"date_updated"`
 }
 
-// CreateIncomingPhoneNumber creates an IncomingPhoneNumber resource via the Twilio REST API.
-// https://www.twilio.com/docs/phone-numbers/api/incomingphonenumber-resource#create-an-incomingphonenumber-resource
-func (twilio *Twilio) CreateSubAccount(options IncomingSubAccount) (*IncomingSubAccount, *Exception, error) {
+// IncomingSubAccount is a deprecated alias for SubAccount.
+//
+// Deprecated: use SubAccount.
+type IncomingSubAccount = SubAccount
+
+// subAccountsPage is the shape of the list response from /Accounts.json.
+type subAccountsPage struct {
+	Accounts []*SubAccount `json:"accounts"`
+}
+
+// SubAccountFilter narrows a ListSubAccounts call. An empty FriendlyName or
+// Status is not sent. PageSize defaults to Twilio's own default when zero.
+type SubAccountFilter struct {
+	FriendlyName string
+	Status       string // active, suspended, or closed
+	PageSize     int
+}
+
+// CreateSubAccount creates a SubAccount resource via the Twilio REST API.
+// https://www.twilio.com/docs/iam/api/account#create-an-account-resource
+func (twilio *Twilio) CreateSubAccount(options SubAccount) (*SubAccount, *Exception, error) {
 	// convert options to HTTP form
 	form, err := query.Values(options)
 	if err != nil {
@@ -37,7 +64,127 @@ func (twilio *Twilio) CreateSubAccount(options IncomingSubAccount) (*IncomingSub
 		return nil, exception, err
 	}
 
-	incomingSubAccount := new(IncomingSubAccount)
-	err = decoder.Decode(incomingSubAccount)
-	return incomingSubAccount, nil, err
+	subAccount := new(SubAccount)
+	err = decoder.Decode(subAccount)
+	return subAccount, nil, err
+}
+
+// ListSubAccounts lists the subaccounts owned by the calling account,
+// optionally narrowed by filter. Only the first page of results is returned
+// (its size set by filter.PageSize); later pages are not fetched.
+// https://www.twilio.com/docs/iam/api/account#read-multiple-account-resources
+func (twilio *Twilio) ListSubAccounts(filter SubAccountFilter) ([]*SubAccount, *Exception, error) {
+	values := url.Values{}
+	if filter.FriendlyName != "" {
+		values.Set("FriendlyName", filter.FriendlyName)
+	}
+	if filter.Status != "" {
+		values.Set("Status", filter.Status)
+	}
+	if filter.PageSize > 0 {
+		values.Set("PageSize", strconv.Itoa(filter.PageSize))
+	}
+
+	twilioUrl := twilio.BaseUrl + "/Accounts.json"
+	if encoded := values.Encode(); encoded != "" {
+		twilioUrl += "?" + encoded
+	}
+
+	res, err := twilio.get(twilioUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return nil, exception, err
+	}
+
+	page := new(subAccountsPage)
+	err = json.Unmarshal(responseBody, page)
+	return page.Accounts, nil, err
+}
+
+// GetSubAccount fetches a single subaccount by its SID.
+// https://www.twilio.com/docs/iam/api/account#fetch-an-account-resource
+func (twilio *Twilio) GetSubAccount(sid string) (*SubAccount, *Exception, error) {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + sid + ".json"
+
+	res, err := twilio.get(twilioUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return nil, exception, err
+	}
+
+	subAccount := new(SubAccount)
+	err = json.Unmarshal(responseBody, subAccount)
+	return subAccount, nil, err
+}
+
+// UpdateSubAccountParams is the set of fields UpdateSubAccount can change.
+// Status transitions a subaccount between active, suspended, and closed;
+// closed is terminal.
+type UpdateSubAccountParams struct {
+	FriendlyName string `url:"FriendlyName,omitempty"`
+	Status       string `url:"Status,omitempty"` // active, suspended, or closed
+}
+
+// UpdateSubAccount renames, suspends, reactivates, or closes a subaccount.
+// https://www.twilio.com/docs/iam/api/account#update-an-account-resource
+func (twilio *Twilio) UpdateSubAccount(sid string, params UpdateSubAccountParams) (*SubAccount, *Exception, error) {
+	form, err := query.Values(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := twilio.post(form, twilio.BaseUrl+"/Accounts/"+sid+".json")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return nil, exception, err
+	}
+
+	subAccount := new(SubAccount)
+	err = json.Unmarshal(responseBody, subAccount)
+	return subAccount, nil, err
+}
+
+// AsSubAccount returns a client scoped to the given subaccount: its
+// AccountSid and AuthToken are the subaccount's own, so calls like SendSMS
+// and GetMessages transparently hit /Accounts/{subSid}/... instead of the
+// parent account's. The returned client shares the parent's HTTPClient,
+// logger, rate limiter, and retry policy.
+func (twilio *Twilio) AsSubAccount(subSid, subAuthToken string) *Twilio {
+	subAccount := *twilio
+	subAccount.AccountSid = subSid
+	subAccount.AuthToken = subAuthToken
+	return &subAccount
 }
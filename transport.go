@@ -0,0 +1,195 @@
+package gotwilio
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ClientOption configures a Twilio client created by NewTwilio.
+type ClientOption func(*Twilio)
+
+// WithHTTPClient overrides the http.Client used for every request. The
+// default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(twilio *Twilio) {
+		twilio.HTTPClient = client
+	}
+}
+
+// WithLogger routes the client's request and retry logging through logger.
+// Without this option, logging is discarded.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(twilio *Twilio) {
+		twilio.logger = logger
+	}
+}
+
+// WithRateLimit caps outgoing requests to rps per second, allowing bursts up
+// to burst requests, via an in-process token bucket. Omit this option to
+// leave requests unlimited.
+func WithRateLimit(rps, burst int) ClientOption {
+	return func(twilio *Twilio) {
+		twilio.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// BackoffFunc returns how long to wait before retry attempt n (1-indexed).
+type BackoffFunc func(attempt int) time.Duration
+
+// ExponentialBackoff returns a BackoffFunc that doubles initial on each
+// attempt: initial, 2*initial, 4*initial, and so on.
+func ExponentialBackoff(initial time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		return initial * time.Duration(math.Pow(2, float64(attempt-1)))
+	}
+}
+
+// WithRetry retries requests that come back 429 or 5xx, up to maxAttempts
+// total tries, sleeping backoff(attempt) between each. A Retry-After header
+// (in seconds) on the retried 429 or 5xx response, when present, overrides
+// backoff for that attempt. Without this option, requests are attempted once.
+func WithRetry(maxAttempts int, backoff BackoffFunc) ClientOption {
+	return func(twilio *Twilio) {
+		twilio.retry = retryConfig{maxAttempts: maxAttempts, backoff: backoff}
+	}
+}
+
+// WithBaseURL overrides the REST API root the client sends requests to,
+// e.g. to point at a mock server in tests. Verify API requests go to their
+// own host and are not affected.
+func WithBaseURL(baseUrl string) ClientOption {
+	return func(twilio *Twilio) {
+		twilio.BaseUrl = baseUrl
+	}
+}
+
+type retryConfig struct {
+	maxAttempts int
+	backoff     BackoffFunc
+}
+
+func (rc retryConfig) enabled() bool {
+	return rc.maxAttempts > 0 && rc.backoff != nil
+}
+
+// do builds and sends a signed request to the Twilio API, applying the
+// client's rate limiter and retry policy around HTTPClient.Do. formValues
+// is nil for a GET, and the form-encoded POST body otherwise.
+func (twilio *Twilio) do(ctx context.Context, method, twilioUrl string, formValues url.Values) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if twilio.limiter != nil {
+			if err := twilio.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := twilio.newSignedRequest(ctx, method, twilioUrl, formValues)
+		if err != nil {
+			return nil, err
+		}
+
+		twilio.logger.Debug("twilio request", "method", method, "url", twilioUrl, "attempt", attempt)
+
+		resp, err := twilio.HTTPClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !twilio.retry.enabled() || attempt >= twilio.retry.maxAttempts || !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := retryAfter(resp, twilio.retry.backoff(attempt))
+		twilio.logger.Warn("twilio retrying", "status", resp.StatusCode, "attempt", attempt, "wait", wait)
+		resp.Body.Close()
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (twilio *Twilio) newSignedRequest(ctx context.Context, method, twilioUrl string, formValues url.Values) (*http.Request, error) {
+	var body io.Reader
+	if formValues != nil {
+		body = strings.NewReader(formValues.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, twilioUrl, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(twilio.AccountSid, twilio.AuthToken)
+	if formValues != nil {
+		req.Header.Add("Accept", "application/json")
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	return req, nil
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+func retryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	return fallback
+}
+
+// rateLimiter is a minimal token-bucket limiter: it refills at refillRate
+// tokens per second up to max, and wait blocks until a token is available
+// or ctx is cancelled.
+type rateLimiter struct {
+	mu         sync.Mutex
+	tokens     float64
+	max        float64
+	refillRate float64
+	last       time.Time
+}
+
+func newRateLimiter(rps, burst int) *rateLimiter {
+	return &rateLimiter{
+		tokens:     float64(burst),
+		max:        float64(burst),
+		refillRate: float64(rps),
+		last:       time.Now(),
+	}
+}
+
+func (rl *rateLimiter) wait(ctx context.Context) error {
+	for {
+		rl.mu.Lock()
+		now := time.Now()
+		rl.tokens = math.Min(rl.max, rl.tokens+now.Sub(rl.last).Seconds()*rl.refillRate)
+		rl.last = now
+
+		if rl.tokens >= 1 {
+			rl.tokens--
+			rl.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - rl.tokens) / rl.refillRate * float64(time.Second))
+		rl.mu.Unlock()
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
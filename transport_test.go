@@ -0,0 +1,90 @@
+package gotwilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRetryHonorsRetryAfter(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	twilio := NewTwilio("AC123", "token", WithBaseURL(server.URL), WithRetry(3, ExponentialBackoff(time.Millisecond)))
+
+	resp, err := twilio.get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the retried request to eventually succeed, got status %d", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("expected exactly one retry (2 attempts), got %d", got)
+	}
+}
+
+func TestWithRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	twilio := NewTwilio("AC123", "token", WithBaseURL(server.URL), WithRetry(3, ExponentialBackoff(time.Millisecond)))
+
+	resp, err := twilio.get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the final 503 to be returned, got status %d", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected exactly maxAttempts (3) tries, got %d", got)
+	}
+}
+
+func TestWithRateLimitBlocksUntilRefill(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Burst of 1 at 20 rps: the first request spends the only token
+	// immediately, and the second has to wait ~50ms for a refill.
+	twilio := NewTwilio("AC123", "token", WithBaseURL(server.URL), WithRateLimit(20, 1))
+
+	resp, err := twilio.get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+	resp.Body.Close()
+
+	start := time.Now()
+	resp, err = twilio.get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error on second request: %v", err)
+	}
+	resp.Body.Close()
+	elapsed := time.Since(start)
+
+	if elapsed < 25*time.Millisecond {
+		t.Fatalf("expected the second request to block for a token refill, took %v", elapsed)
+	}
+}
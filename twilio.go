@@ -0,0 +1,91 @@
+package gotwilio
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const defaultBaseUrl = "https://api.twilio.com/2010-04-01"
+
+// Twilio stores basic information important for connecting to the
+// twilio.com REST API such as AccountSid and AuthToken.
+type Twilio struct {
+	AccountSid string
+	AuthToken  string
+	BaseUrl    string
+	HTTPClient *http.Client
+
+	logger  *slog.Logger
+	limiter *rateLimiter
+	retry   retryConfig
+}
+
+// Exception is a representation of a Twilio exception.
+type Exception struct {
+	Status   int    `json:"status"`
+	Message  string `json:"message"`
+	Code     int    `json:"code"`
+	MoreInfo string `json:"more_info"`
+}
+
+// Error implements the error interface so an *Exception can be returned
+// anywhere ordinary Go code expects an error.
+func (exception *Exception) Error() string {
+	return exception.Message
+}
+
+// ListResources holds a page of SMS messages returned from the Messages
+// resource, along with the paging metadata needed to fetch the next page.
+type ListResources struct {
+	Messages    []*SmsResponse `json:"messages"`
+	NextPageUri string         `json:"next_page_uri"`
+}
+
+func (twilio *Twilio) newListResources() *ListResources {
+	return new(ListResources)
+}
+
+// NewTwilio creates a Twilio client for accountSid/authToken, applying opts
+// in order. With no opts, requests are unlimited and attempted exactly
+// once, matching the module's historical behavior; see WithRateLimit,
+// WithRetry, WithHTTPClient, WithLogger, and WithBaseURL to change that.
+func NewTwilio(accountSid, authToken string, opts ...ClientOption) *Twilio {
+	twilio := &Twilio{
+		AccountSid: accountSid,
+		AuthToken:  authToken,
+		BaseUrl:    defaultBaseUrl,
+		HTTPClient: http.DefaultClient,
+		logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	for _, opt := range opts {
+		opt(twilio)
+	}
+
+	return twilio
+}
+
+// NewTwilioClient creates a new Twilio struct using the default http client.
+func NewTwilioClient(accountSid, authToken string) *Twilio {
+	return NewTwilio(accountSid, authToken)
+}
+
+// NewTwilioClientCustomHTTP creates a new Twilio struct using a custom http.Client.
+func NewTwilioClientCustomHTTP(accountSid, authToken string, HTTPClient *http.Client) *Twilio {
+	if HTTPClient == nil {
+		HTTPClient = http.DefaultClient
+	}
+
+	return NewTwilio(accountSid, authToken, WithHTTPClient(HTTPClient))
+}
+
+func (twilio *Twilio) get(twilioUrl string) (*http.Response, error) {
+	return twilio.do(context.Background(), http.MethodGet, twilioUrl, nil)
+}
+
+func (twilio *Twilio) post(formValues url.Values, twilioUrl string) (*http.Response, error) {
+	return twilio.do(context.Background(), http.MethodPost, twilioUrl, formValues)
+}
@@ -0,0 +1,124 @@
+package gotwilio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// verifyBaseUrl is the base URL for the Twilio Verify API, which lives on
+// its own host and API version rather than under Twilio's main BaseUrl.
+const verifyBaseUrl = "https://verify.twilio.com/v2"
+
+// VerifyService represents a Verify Service resource, the container that
+// OTP verifications are sent and checked against.
+// See https://www.twilio.com/docs/verify/api/service for more information.
+type VerifyService struct {
+	Sid          string `json:"sid"`
+	FriendlyName string `json:"friendly_name"`
+	AccountSid   string `json:"account_sid"`
+	DateCreated  string `json:"date_created"`
+	DateUpdated  string `json:"date_updated"`
+}
+
+// VerificationResponse is returned after starting or checking a verification.
+// See https://www.twilio.com/docs/verify/api/verification and
+// https://www.twilio.com/docs/verify/api/verification-check for more information.
+type VerificationResponse struct {
+	Sid         string `json:"sid"`
+	ServiceSid  string `json:"service_sid"`
+	AccountSid  string `json:"account_sid"`
+	To          string `json:"to"`
+	Channel     string `json:"channel"`
+	Status      string `json:"status"`
+	Valid       bool   `json:"valid"`
+	DateCreated string `json:"date_created"`
+	DateUpdated string `json:"date_updated"`
+}
+
+// CreateVerifyService creates a Verify Service via the Twilio REST API.
+// See https://www.twilio.com/docs/verify/api/service#create-a-service for more information.
+func (twilio *Twilio) CreateVerifyService(friendlyName string) (*VerifyService, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("FriendlyName", friendlyName)
+
+	twilioUrl := verifyBaseUrl + "/Services"
+
+	res, err := twilio.post(formValues, twilioUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		exception := new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return nil, exception, err
+	}
+
+	verifyService := new(VerifyService)
+	err = json.Unmarshal(responseBody, verifyService)
+	return verifyService, nil, err
+}
+
+// StartVerification begins a new verification for the given phone number or
+// email address, sending the OTP over the requested channel ("sms", "call",
+// or "email"). locale, if non-empty, selects the language the OTP message is
+// sent in.
+// See https://www.twilio.com/docs/verify/api/verification#start-new-verification for more information.
+func (twilio *Twilio) StartVerification(serviceSid, to, channel, locale string) (*VerificationResponse, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("To", to)
+	formValues.Set("Channel", channel)
+	if locale != "" {
+		formValues.Set("Locale", locale)
+	}
+
+	twilioUrl := verifyBaseUrl + "/Services/" + serviceSid + "/Verifications"
+
+	return twilio.sendVerifyRequest(formValues, twilioUrl)
+}
+
+// CheckVerification confirms the code a user received against a pending
+// verification for the given phone number or email address.
+// See https://www.twilio.com/docs/verify/api/verification-check#check-a-verification-with-a-code for more information.
+func (twilio *Twilio) CheckVerification(serviceSid, to, code string) (*VerificationResponse, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("To", to)
+	formValues.Set("Code", code)
+
+	twilioUrl := verifyBaseUrl + "/Services/" + serviceSid + "/VerificationCheck"
+
+	return twilio.sendVerifyRequest(formValues, twilioUrl)
+}
+
+// Core method shared by StartVerification and CheckVerification: both POST
+// a form to the Verify API and decode the same VerificationResponse shape.
+func (twilio *Twilio) sendVerifyRequest(formValues url.Values, twilioUrl string) (verificationResponse *VerificationResponse, exception *Exception, err error) {
+	res, err := twilio.post(formValues, twilioUrl)
+	if err != nil {
+		return verificationResponse, exception, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return verificationResponse, exception, err
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		exception = new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return verificationResponse, exception, err
+	}
+
+	verificationResponse = new(VerificationResponse)
+	err = json.Unmarshal(responseBody, verificationResponse)
+	return verificationResponse, exception, err
+}
@@ -0,0 +1,88 @@
+package gotwilio
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// CallResponse is returned after a call is placed through Twilio.
+type CallResponse struct {
+	Sid            string  `json:"sid"`
+	DateCreated    string  `json:"date_created"`
+	DateUpdated    string  `json:"date_updated"`
+	AccountSid     string  `json:"account_sid"`
+	To             string  `json:"to"`
+	From           string  `json:"from"`
+	PhoneNumberSid string  `json:"phone_number_sid"`
+	Status         string  `json:"status"`
+	StartTime      string  `json:"start_time"`
+	EndTime        string  `json:"end_time"`
+	Duration       string  `json:"duration"`
+	Price          *string `json:"price,omitempty"`
+	Direction      string  `json:"direction"`
+	AnsweredBy     string  `json:"answered_by"`
+	ApiVersion     string  `json:"api_version"`
+	Url            string  `json:"uri"`
+}
+
+// messageTwiml is the TwiML document played for CallPhoneWithMessage. Say is
+// encoded via encoding/xml, which escapes its text content, so callers can't
+// inject markup through topic/message/footer.
+type messageTwiml struct {
+	XMLName xml.Name `xml:"Response"`
+	Pause   struct{} `xml:"Pause"`
+	Say     []string `xml:"Say"`
+}
+
+// CallPhoneWithMessage places a call that reads topic, message, and an
+// optional footer to whoever answers, e.g. "You have a message on billing.
+// Your invoice is overdue. Reply STOP to opt out." It's the voice-call
+// counterpart to SendSMS for alerts that warrant a phone call.
+// See https://www.twilio.com/docs/voice/twiml and
+// https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource for more information.
+func (twilio *Twilio) CallPhoneWithMessage(from, to, topic, message, footer, statusCallback string) (callResponse *CallResponse, exception *Exception, err error) {
+	says := []string{fmt.Sprintf("You have a message on %s", topic), message}
+	if footer != "" {
+		says = append(says, footer)
+	}
+
+	twiml, err := xml.Marshal(messageTwiml{Say: says})
+	if err != nil {
+		return callResponse, exception, err
+	}
+
+	formValues := url.Values{}
+	formValues.Set("From", from)
+	formValues.Set("To", to)
+	formValues.Set("Twiml", string(twiml))
+	if statusCallback != "" {
+		formValues.Set("StatusCallback", statusCallback)
+	}
+
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Calls.json"
+
+	res, err := twilio.post(formValues, twilioUrl)
+	if err != nil {
+		return callResponse, exception, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return callResponse, exception, err
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		exception = new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return callResponse, exception, err
+	}
+
+	callResponse = new(CallResponse)
+	err = json.Unmarshal(responseBody, callResponse)
+	return callResponse, exception, err
+}
@@ -0,0 +1,177 @@
+package gotwilio
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ValidateRequest verifies the X-Twilio-Signature header on an inbound
+// webhook request against authToken, per Twilio's request validation
+// scheme: https://www.twilio.com/docs/usage/security#validating-requests.
+//
+// For form-encoded webhooks (SMS/voice status callbacks) the signing string
+// is the request URL followed by each POSTed parameter's key and value,
+// sorted lexicographically by key and concatenated with no delimiter. For
+// JSON webhooks it's the request URL followed by the raw request body. The
+// resulting string is HMAC-SHA1'd with authToken as the key, base64-encoded,
+// and compared to the header in constant time.
+//
+// For a normally-routed net/http server request, r.URL carries no scheme or
+// host (Go only populates those from an absolute-form request target, which
+// Twilio doesn't send), so ValidateRequest reconstructs the externally
+// visible URL from r.Host and a scheme inferred from r.TLS/
+// X-Forwarded-Proto. SignatureVerifier's publicHost option overrides that
+// reconstruction for apps sitting behind a proxy that rewrites the host.
+func ValidateRequest(r *http.Request, authToken string) bool {
+	signature := r.Header.Get("X-Twilio-Signature")
+	if signature == "" {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	requestUrl := signingUrl(r)
+
+	var signingString string
+	if isJSONContentType(r.Header.Get("Content-Type")) {
+		signingString = requestUrl + string(body)
+	} else {
+		form, err := parseFormBody(body)
+		if err != nil {
+			return false
+		}
+		signingString = requestUrl + sortedFormParams(form)
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(signingString))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) == 1
+}
+
+// SignatureVerifier returns net/http middleware that rejects any request
+// whose X-Twilio-Signature doesn't validate against authToken with a 403,
+// and otherwise forwards to next unchanged.
+//
+// By default the signing string is built from r.Host and a scheme inferred
+// from r.TLS/X-Forwarded-Proto, which is correct for most deployments. If
+// the app sits behind a proxy or load balancer that rewrites the host, pass
+// publicHost as the scheme+host Twilio actually sent the request to (e.g.
+// "https://example.com") to override that inference.
+func SignatureVerifier(authToken string, publicHost ...string) func(http.Handler) http.Handler {
+	var host string
+	if len(publicHost) > 0 {
+		host = publicHost[0]
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req := r
+			if host != "" {
+				req = r.Clone(r.Context())
+				scheme, hostname, found := strings.Cut(host, "://")
+				if !found {
+					scheme, hostname = "https", host
+				}
+				req.URL.Scheme, req.URL.Host = scheme, hostname
+			}
+
+			valid := ValidateRequest(req, authToken)
+			// ValidateRequest drains Body and replaces it on whichever of
+			// r/req it validated; Clone copies the Body field shallowly, so
+			// a clone's reader is drained but only req.Body is replaced.
+			// Keep r in sync before handing it to next.
+			r.Body = req.Body
+
+			if !valid {
+				http.Error(w, "invalid Twilio signature", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// signingUrl reconstructs the externally visible URL for r: r.URL.Scheme and
+// r.URL.Host when SignatureVerifier has already overridden them for a
+// proxied deployment, otherwise r.Host with a scheme inferred from r.TLS or
+// X-Forwarded-Proto.
+func signingUrl(r *http.Request) string {
+	scheme, host := r.URL.Scheme, r.URL.Host
+	if host == "" {
+		host = r.Host
+	}
+	if scheme == "" {
+		scheme = requestScheme(r)
+	}
+
+	return scheme + "://" + host + r.URL.RequestURI()
+}
+
+func requestScheme(r *http.Request) string {
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		return proto
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// isJSONContentType reports whether contentType's media type is exactly
+// application/json, ignoring parameters like charset. Suffixed types such as
+// application/vnd.example+json do not match.
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json"
+}
+
+// parseFormBody parses an application/x-www-form-urlencoded body without
+// consuming r.Body, so ValidateRequest can restore it for downstream
+// handlers.
+func parseFormBody(body []byte) (map[string][]string, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// sortedFormParams concatenates each form key with its value(s), sorted
+// lexicographically by key, as required by Twilio's signing scheme.
+// Repeated keys contribute one key+value pair per value.
+func sortedFormParams(form map[string][]string) string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var params strings.Builder
+	for _, key := range keys {
+		for _, value := range form[key] {
+			params.WriteString(key)
+			params.WriteString(value)
+		}
+	}
+
+	return params.String()
+}
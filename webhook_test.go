@@ -0,0 +1,111 @@
+package gotwilio
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// signLikeTwilio computes the same signature Twilio sends in
+// X-Twilio-Signature, so tests can round-trip a request through
+// ValidateRequest without depending on the package's own signing code.
+func signLikeTwilio(authToken, fullURL string, form url.Values, body string) string {
+	signingString := fullURL
+	if form != nil {
+		keys := make([]string, 0, len(form))
+		for key := range form {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, value := range form[key] {
+				signingString += key + value
+			}
+		}
+	} else {
+		signingString += body
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(signingString))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidateRequestFormEncoded(t *testing.T) {
+	const authToken = "test-auth-token"
+	form := url.Values{"To": {"+15551234567"}, "From": {"+15557654321"}, "Body": {"hello"}}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/sms", strings.NewReader(form.Encode()))
+	req.Host = "example.com"
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Twilio-Signature", signLikeTwilio(authToken, "http://example.com/webhooks/sms", form, ""))
+
+	if !ValidateRequest(req, authToken) {
+		t.Fatal("expected a correctly signed, non-proxied request to validate")
+	}
+}
+
+func TestValidateRequestRejectsTamperedSignature(t *testing.T) {
+	const authToken = "test-auth-token"
+	form := url.Values{"To": {"+15551234567"}}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/sms", strings.NewReader(form.Encode()))
+	req.Host = "example.com"
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Twilio-Signature", signLikeTwilio(authToken, "http://example.com/webhooks/sms", form, ""))
+
+	req.Header.Set("X-Twilio-Signature", "not-the-right-signature")
+	if ValidateRequest(req, authToken) {
+		t.Fatal("expected a tampered signature to fail validation")
+	}
+}
+
+func TestValidateRequestJSONWithCharsetContentType(t *testing.T) {
+	const authToken = "test-auth-token"
+	body := `{"MessageStatus":"delivered"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/status", strings.NewReader(body))
+	req.Host = "example.com"
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("X-Twilio-Signature", signLikeTwilio(authToken, "http://example.com/webhooks/status", nil, body))
+
+	if !ValidateRequest(req, authToken) {
+		t.Fatal("expected a JSON webhook with a charset parameter to validate")
+	}
+}
+
+func TestSignatureVerifierPublicHost(t *testing.T) {
+	const authToken = "test-auth-token"
+	form := url.Values{"To": {"+15551234567"}}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/sms", strings.NewReader(form.Encode()))
+	req.Host = "internal.local:8080"
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Twilio-Signature", signLikeTwilio(authToken, "https://example.com/webhooks/sms", form, ""))
+
+	var called bool
+	var bodyAtHandler string
+	handler := SignatureVerifier(authToken, "https://example.com")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		b, _ := io.ReadAll(r.Body)
+		bodyAtHandler = string(b)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if !called {
+		t.Fatalf("expected request signed for the public host to pass, got status %d", rec.Code)
+	}
+	if bodyAtHandler != form.Encode() {
+		t.Fatalf("expected downstream handler to see the posted body, got %q", bodyAtHandler)
+	}
+}